@@ -11,6 +11,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -33,6 +34,18 @@ var defaultOptFields = map[string][]string{
 var (
 	// ErrUnauthorized can be returned on any call on response status code 401.
 	ErrUnauthorized = errors.New("asana: unauthorized")
+	// ErrForbidden is returned on response status code 403.
+	ErrForbidden = errors.New("asana: forbidden")
+	// ErrNotFound is returned on response status code 404.
+	ErrNotFound = errors.New("asana: not found")
+	// ErrInvalidRequest is returned on response status code 400.
+	ErrInvalidRequest = errors.New("asana: invalid request")
+	// ErrPremiumOnly is returned on response status code 402, for
+	// features that require a premium Asana plan.
+	ErrPremiumOnly = errors.New("asana: premium only")
+	// ErrRateLimited is returned on response status code 429, after the
+	// client's RetryPolicy has given up retrying.
+	ErrRateLimited = errors.New("asana: rate limited")
 )
 
 type (
@@ -50,6 +63,13 @@ type (
 		doer      Doer
 		BaseURL   *url.URL
 		UserAgent string
+
+		// RetryPolicy controls retries of 429 and 5xx responses. It
+		// defaults to DefaultRetryPolicy; set MaxRetries to 0 to disable.
+		RetryPolicy RetryPolicy
+
+		mu        sync.Mutex
+		rateLimit RateLimit
 	}
 
 	Workspace struct {
@@ -84,15 +104,27 @@ type (
 		Name           string    `json:"name,omitempty"`
 		Hearts         []Heart   `json:"hearts,omitempty"`
 		Notes          string    `json:"notes,omitempty"`
+		HTMLNotes      string    `json:"html_notes,omitempty"`
 		ParentTask     *Task     `json:"parent,omitempty"`
 		Projects       []Project `json:"projects,omitempty"`
 		DueOn          string    `json:"due_on,omitempty"`
 		DueAt          string    `json:"due_at,omitempty"`
 	}
-	// TaskUpdate is used to update a task.
+	// TaskUpdate is used to update a task. Fields are pointers so the zero
+	// value (nil) means "leave unchanged" rather than "clear this field".
+	// Projects is a pointer to a slice so that a non-nil, empty slice can
+	// be sent to clear a task's projects, distinct from nil meaning leave
+	// them unchanged.
 	TaskUpdate struct {
-		Notes   *string `json:"notes,omitempty"`
-		Hearted *bool   `json:"hearted,omitempty"`
+		Name      *string   `json:"name,omitempty"`
+		Assignee  *string   `json:"assignee,omitempty"`
+		Completed *bool     `json:"completed,omitempty"`
+		DueOn     *string   `json:"due_on,omitempty"`
+		DueAt     *string   `json:"due_at,omitempty"`
+		Notes     *string   `json:"notes,omitempty"`
+		HTMLNotes *string   `json:"html_notes,omitempty"`
+		Hearted   *bool     `json:"hearted,omitempty"`
+		Projects  *[]string `json:"projects,omitempty"`
 	}
 
 	Story struct {
@@ -101,9 +133,24 @@ type (
 		CreatedBy User      `json:"created_by,omitempty"`
 		Hearts    []Heart   `json:"hearts,omitempty"`
 		Text      string    `json:"text,omitempty"`
+		HTMLText  string    `json:"html_text,omitempty"`
 		Type      string    `json:"type,omitempty"` // E.g., "comment", "system".
 	}
 
+	// StoryCreate is used to create a new story (e.g. a comment) on a
+	// task. Either Text or HTMLText should be set, not both.
+	StoryCreate struct {
+		Text        string `json:"text,omitempty"`
+		HTMLText    string `json:"html_text,omitempty"`
+		IsPinned    bool   `json:"is_pinned,omitempty"`
+		StickerName string `json:"sticker_name,omitempty"`
+	}
+
+	// StoryUpdate is used to update a story.
+	StoryUpdate struct {
+		IsPinned *bool `json:"is_pinned,omitempty"`
+	}
+
 	// Heart represents a ♥ action by a user.
 	Heart struct {
 		ID   string `json:"gid,omitempty"`
@@ -126,6 +173,8 @@ type (
 		ModifiedSince  string   `url:"modified_since,omitempty"`
 		OptFields      []string `url:"opt_fields,comma,omitempty"`
 		OptExpand      []string `url:"opt_expand,comma,omitempty"`
+		Limit          int      `url:"limit,omitempty"`
+		Offset         string   `url:"offset,omitempty"`
 	}
 
 	request struct {
@@ -133,17 +182,45 @@ type (
 	}
 
 	Response struct {
-		Data   interface{} `json:"data,omitempty"`
-		Errors Errors      `json:"errors,omitempty"`
+		Data     interface{} `json:"data,omitempty"`
+		Errors   Errors      `json:"errors,omitempty"`
+		NextPage *NextPage   `json:"next_page,omitempty"`
+	}
+
+	// NextPage carries the cursor Asana returns alongside paged list
+	// results. A nil NextPage, or one with an empty Offset, means
+	// there are no further pages.
+	NextPage struct {
+		Offset string `json:"offset,omitempty"`
+		Path   string `json:"path,omitempty"`
+		URI    string `json:"uri,omitempty"`
 	}
 
 	Error struct {
 		Phrase  string `json:"phrase,omitempty"`
 		Message string `json:"message,omitempty"`
+		Help    string `json:"help,omitempty"`
+
+		// StatusCode and RequestID are populated from the HTTP response
+		// rather than the JSON body; they are the same for every Error in
+		// a given Errors slice.
+		StatusCode int    `json:"-"`
+		RequestID  string `json:"-"`
 	}
 
 	// Errors always has at least 1 element when returned.
 	Errors []Error
+
+	// ErrorResponse wraps the Asana error payload for a single request
+	// together with the HTTP status code and the Asana-Request-Id header.
+	// It unwraps to one of the sentinel errors above (ErrNotFound,
+	// ErrForbidden, ...) based on StatusCode, so callers can use
+	// errors.Is/errors.As instead of string matching.
+	ErrorResponse struct {
+		Errors     Errors
+		StatusCode int
+		RequestID  string
+	}
 )
 
 func (f DoerFunc) Do(req *http.Request) (resp *http.Response, err error) {
@@ -162,6 +239,34 @@ func (e Errors) Error() string {
 	return strings.Join(sErrs, ", ")
 }
 
+func (e *ErrorResponse) Error() string {
+	if e.RequestID != "" {
+		return fmt.Sprintf("asana: %v (status %d, request %s)", e.Errors.Error(), e.StatusCode, e.RequestID)
+	}
+	return fmt.Sprintf("asana: %v (status %d)", e.Errors.Error(), e.StatusCode)
+}
+
+// Unwrap lets errors.Is/errors.As match e against the sentinel error for
+// its StatusCode (e.g. errors.Is(err, asana.ErrNotFound)).
+func (e *ErrorResponse) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusPaymentRequired:
+		return ErrPremiumOnly
+	case http.StatusBadRequest:
+		return ErrInvalidRequest
+	default:
+		return nil
+	}
+}
+
 // NewClient created new asana client with doer.
 // If doer is nil then http.DefaultClient used intead.
 func NewClient(doer Doer) *Client {
@@ -169,7 +274,7 @@ func NewClient(doer Doer) *Client {
 		doer = http.DefaultClient
 	}
 	baseURL, _ := url.Parse(defaultBaseURL)
-	client := &Client{doer: doer, BaseURL: baseURL, UserAgent: userAgent}
+	client := &Client{doer: doer, BaseURL: baseURL, UserAgent: userAgent, RetryPolicy: DefaultRetryPolicy}
 	return client
 }
 
@@ -208,7 +313,7 @@ func (c *Client) GetTask(ctx context.Context, id string, opt *Filter) (Task, err
 // https://asana.com/developers/api-reference/tasks#update
 func (c *Client) UpdateTask(ctx context.Context, id string, tu TaskUpdate, opt *Filter) (Task, error) {
 	task := new(Task)
-	err := c.request(ctx, "PUT", fmt.Sprintf("tasks/%s", id), tu, nil, opt, task)
+	_, err := c.request(ctx, "PUT", fmt.Sprintf("tasks/%s", id), tu, nil, opt, task)
 	return *task, err
 }
 
@@ -217,7 +322,7 @@ func (c *Client) UpdateTask(ctx context.Context, id string, tu TaskUpdate, opt *
 // https://asana.com/developers/api-reference/tasks#create
 func (c *Client) CreateTask(ctx context.Context, fields map[string]string, opts *Filter) (Task, error) {
 	task := new(Task)
-	err := c.request(ctx, "POST", "tasks", nil, toURLValues(fields), opts, task)
+	_, err := c.request(ctx, "POST", "tasks", nil, toURLValues(fields), opts, task)
 	return *task, err
 }
 
@@ -233,6 +338,32 @@ func (c *Client) ListTaskStories(ctx context.Context, taskID string, opt *Filter
 	return *stories, err
 }
 
+// CreateStory adds a comment story to task taskID.
+//
+// https://asana.com/developers/api-reference/stories#create
+func (c *Client) CreateStory(ctx context.Context, taskID string, story StoryCreate, opt *Filter) (Story, error) {
+	s := new(Story)
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/stories", taskID), story, nil, opt, s)
+	return *s, err
+}
+
+// UpdateStory updates story id.
+//
+// https://asana.com/developers/api-reference/stories#update
+func (c *Client) UpdateStory(ctx context.Context, id string, su StoryUpdate, opt *Filter) (Story, error) {
+	s := new(Story)
+	_, err := c.request(ctx, "PUT", fmt.Sprintf("stories/%s", id), su, nil, opt, s)
+	return *s, err
+}
+
+// DeleteStory deletes story id.
+//
+// https://asana.com/developers/api-reference/stories#delete
+func (c *Client) DeleteStory(ctx context.Context, id string) error {
+	_, err := c.request(ctx, "DELETE", fmt.Sprintf("stories/%s", id), nil, nil, nil, nil)
+	return err
+}
+
 func (c *Client) ListTags(ctx context.Context, opt *Filter) ([]Tag, error) {
 	tags := new([]Tag)
 	err := c.Request(ctx, "tags", opt, tags)
@@ -252,14 +383,16 @@ func (c *Client) GetUserByID(ctx context.Context, id string, opt *Filter) (User,
 }
 
 func (c *Client) Request(ctx context.Context, path string, opt *Filter, v interface{}) error {
-	return c.request(ctx, "GET", path, nil, nil, opt, v)
+	_, err := c.request(ctx, "GET", path, nil, nil, opt, v)
+	return err
 }
 
 // request makes a request to Asana API, using method, at path, sending data or form with opt filter.
 // Only data or form could be sent at the same time. If both provided form will be omitted.
 // Also it's possible to do request with nil data and form.
-// The response is populated into v, and any error is returned.
-func (c *Client) request(ctx context.Context, method string, path string, data interface{}, form url.Values, opt *Filter, v interface{}) error {
+// The response is populated into v, and the NextPage cursor (nil on non-paged
+// endpoints or on the last page) and any error are returned.
+func (c *Client) request(ctx context.Context, method string, path string, data interface{}, form url.Values, opt *Filter, v interface{}) (*NextPage, error) {
 	if opt == nil {
 		opt = &Filter{}
 	}
@@ -271,50 +404,85 @@ func (c *Client) request(ctx context.Context, method string, path string, data i
 	}
 	urlStr, err := addOptions(path, opt)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	rel, err := url.Parse(urlStr)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	u := c.BaseURL.ResolveReference(rel)
-	var body io.Reader
-	if data != nil {
-		b, err := json.Marshal(request{Data: data})
+
+	var bodyBytes []byte
+	var contentType string
+	switch {
+	case data != nil:
+		bodyBytes, err = json.Marshal(request{Data: data})
 		if err != nil {
-			return err
+			return nil, err
 		}
-		body = bytes.NewReader(b)
-	} else if form != nil {
-		body = strings.NewReader(form.Encode())
-	}
-	req, err := http.NewRequest(method, u.String(), body)
-	if err != nil {
-		return err
+		contentType = "application/json"
+	case form != nil:
+		bodyBytes = []byte(form.Encode())
+		contentType = "application/x-www-form-urlencoded"
 	}
 
-	if data != nil {
-		req.Header.Set("Content-Type", "application/json")
-	} else if form != nil {
-		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
-	}
+	return c.doWithRetry(ctx, method, u.String(), bodyBytes, contentType, v)
+}
 
-	req.Header.Set("User-Agent", c.UserAgent)
-	resp, err := c.doer.Do(req.WithContext(ctx))
-	if err != nil {
-		return err
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode == http.StatusUnauthorized {
-		return ErrUnauthorized
-	}
+// doWithRetry sends a method request to urlStr with the given body bytes
+// (nil for none) and Content-Type, retrying per c.RetryPolicy on 429/5xx
+// responses, then decodes the result into v. It is the shared tail end of
+// every Client call that talks to the API, JSON/form-encoded or
+// multipart, so retry and error-wrapping behavior stays consistent across
+// all of them.
+func (c *Client) doWithRetry(ctx context.Context, method, urlStr string, bodyBytes []byte, contentType string, v interface{}) (*NextPage, error) {
+	for attempt := 0; ; attempt++ {
+		var body io.Reader
+		if bodyBytes != nil {
+			body = bytes.NewReader(bodyBytes)
+		}
+		req, err := http.NewRequest(method, urlStr, body)
+		if err != nil {
+			return nil, err
+		}
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		req.Header.Set("User-Agent", c.UserAgent)
 
-	res := &Response{Data: v}
-	err = json.NewDecoder(resp.Body).Decode(res)
-	if len(res.Errors) > 0 {
-		return res.Errors
+		resp, err := c.doer.Do(req.WithContext(ctx))
+		if err != nil {
+			return nil, err
+		}
+		c.recordRateLimit(resp.Header)
+
+		if attempt < c.RetryPolicy.MaxRetries && c.RetryPolicy.shouldRetry(resp.StatusCode) {
+			retryAfter := resp.Header.Get("Retry-After")
+			resp.Body.Close()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(c.RetryPolicy.delay(attempt, retryAfter)):
+			}
+			continue
+		}
+
+		res := &Response{Data: v}
+		decodeErr := json.NewDecoder(resp.Body).Decode(res)
+		resp.Body.Close()
+		if len(res.Errors) > 0 || resp.StatusCode >= http.StatusBadRequest {
+			if decodeErr != nil {
+				return nil, fmt.Errorf("asana: could not decode error response (status %d): %w", resp.StatusCode, decodeErr)
+			}
+			requestID := resp.Header.Get("Asana-Request-Id")
+			for i := range res.Errors {
+				res.Errors[i].StatusCode = resp.StatusCode
+				res.Errors[i].RequestID = requestID
+			}
+			return nil, &ErrorResponse{Errors: res.Errors, StatusCode: resp.StatusCode, RequestID: requestID}
+		}
+		return res.NextPage, decodeErr
 	}
-	return err
 }
 
 func addOptions(s string, opt interface{}) (string, error) {
@@ -1,6 +1,7 @@
 package asana
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -52,19 +53,19 @@ func TestListWorkspaces(t *testing.T) {
 
 	mux.HandleFunc("/workspaces", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, `{"data":[
-			{"id":1,"name":"Organization 1"},
-			{"id":2,"name":"Organization 2"}
+			{"gid":"1","name":"Organization 1"},
+			{"gid":"2","name":"Organization 2"}
 		]}`)
 	})
 
-	workspaces, err := client.ListWorkspaces()
+	workspaces, err := client.ListWorkspaces(context.Background())
 	if err != nil {
 		t.Errorf("ListWorkspaces returned error: %v", err)
 	}
 
 	want := []Workspace{
-		{ID: 1, Name: "Organization 1"},
-		{ID: 2, Name: "Organization 2"},
+		{ID: "1", Name: "Organization 1"},
+		{ID: "2", Name: "Organization 2"},
 	}
 
 	if !reflect.DeepEqual(workspaces, want) {
@@ -78,19 +79,19 @@ func TestListUsers(t *testing.T) {
 
 	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, `{"data":[
-			{"id":1,"email":"test1@asana.com"},
-			{"id":2,"email":"test2@asana.com"}
+			{"gid":"1","email":"test1@asana.com"},
+			{"gid":"2","email":"test2@asana.com"}
 		]}`)
 	})
 
-	users, err := client.ListUsers(nil)
+	users, err := client.ListUsers(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListUsers returned error: %v", err)
 	}
 
 	want := []User{
-		{ID: 1, Email: "test1@asana.com"},
-		{ID: 2, Email: "test2@asana.com"},
+		{ID: "1", Email: "test1@asana.com"},
+		{ID: "2", Email: "test2@asana.com"},
 	}
 
 	if !reflect.DeepEqual(users, want) {
@@ -104,19 +105,19 @@ func TestListProjects(t *testing.T) {
 
 	mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, `{"data":[
-			{"id":1,"name":"Project 1"},
-			{"id":2,"name":"Project 2"}
+			{"gid":"1","name":"Project 1"},
+			{"gid":"2","name":"Project 2"}
 		]}`)
 	})
 
-	projects, err := client.ListProjects(nil)
+	projects, err := client.ListProjects(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListProjects returned error: %v", err)
 	}
 
 	want := []Project{
-		{ID: 1, Name: "Project 1"},
-		{ID: 2, Name: "Project 2"},
+		{ID: "1", Name: "Project 1"},
+		{ID: "2", Name: "Project 2"},
 	}
 
 	if !reflect.DeepEqual(projects, want) {
@@ -130,19 +131,19 @@ func TestListTasks(t *testing.T) {
 
 	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, `{"data":[
-			{"id":1,"name":"Task 1"},
-			{"id":2,"name":"Task 2"}
+			{"gid":"1","name":"Task 1"},
+			{"gid":"2","name":"Task 2"}
 		]}`)
 	})
 
-	tasks, err := client.ListTasks(nil)
+	tasks, err := client.ListTasks(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListTasks returned error: %v", err)
 	}
 
 	want := []Task{
-		{ID: 1, Name: "Task 1"},
-		{ID: 2, Name: "Task 2"},
+		{ID: "1", Name: "Task 1"},
+		{ID: "2", Name: "Task 2"},
 	}
 
 	if !reflect.DeepEqual(tasks, want) {
@@ -169,7 +170,7 @@ func TestUpdateTask(t *testing.T) {
 			t.Errorf("handler received request body %+v, want %+v", string(b), want)
 		}
 
-		fmt.Fprint(w, `{"data":{"id":1,"notes":"updated notes"}}`)
+		fmt.Fprint(w, `{"data":{"gid":"1","notes":"updated notes"}}`)
 	})
 
 	// TODO: Add this to package API, like go-github, maybe? Think about it first.
@@ -178,12 +179,42 @@ func TestUpdateTask(t *testing.T) {
 	// to store v and returns a pointer to it.
 	String := func(v string) *string { return &v }
 
-	task, err := client.UpdateTask(1, TaskUpdate{Notes: String("updated notes")}, nil)
+	task, err := client.UpdateTask(context.Background(), "1", TaskUpdate{Notes: String("updated notes")}, nil)
 	if err != nil {
 		t.Errorf("UpdateTask returned error: %v", err)
 	}
 
-	want := Task{ID: 1, Notes: "updated notes"}
+	want := Task{ID: "1", Notes: "updated notes"}
+	if !reflect.DeepEqual(task, want) {
+		t.Errorf("UpdateTask returned %+v, want %+v", task, want)
+	}
+}
+
+func TestUpdateTaskClearsProjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tasks/1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "PUT")
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("error reading request body: %v", err)
+		}
+		want := `{"data":{"projects":[]}}`
+		if string(b) != want {
+			t.Errorf("handler received request body %+v, want %+v", string(b), want)
+		}
+
+		fmt.Fprint(w, `{"data":{"gid":"1"}}`)
+	})
+
+	empty := []string{}
+	task, err := client.UpdateTask(context.Background(), "1", TaskUpdate{Projects: &empty}, nil)
+	if err != nil {
+		t.Errorf("UpdateTask returned error: %v", err)
+	}
+
+	want := Task{ID: "1"}
 	if !reflect.DeepEqual(task, want) {
 		t.Errorf("UpdateTask returned %+v, want %+v", task, want)
 	}
@@ -195,19 +226,19 @@ func TestListTags(t *testing.T) {
 
 	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
 		fmt.Fprint(w, `{"data":[
-			{"id":1,"name":"Tag 1"},
-			{"id":2,"name":"Tag 2"}
+			{"gid":"1","name":"Tag 1"},
+			{"gid":"2","name":"Tag 2"}
 		]}`)
 	})
 
-	tags, err := client.ListTags(nil)
+	tags, err := client.ListTags(context.Background(), nil)
 	if err != nil {
 		t.Errorf("ListTags returned error: %v", err)
 	}
 
 	want := []Tag{
-		{ID: 1, Name: "Tag 1"},
-		{ID: 2, Name: "Tag 2"},
+		{ID: "1", Name: "Tag 1"},
+		{ID: "2", Name: "Tag 2"},
 	}
 
 	if !reflect.DeepEqual(tags, want) {
@@ -0,0 +1,120 @@
+package asana
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// Endpoint is Asana's OAuth2 endpoint, for use with an oauth2.Config.
+//
+// https://developers.asana.com/docs/oauth
+var Endpoint = oauth2.Endpoint{
+	AuthURL:  "https://app.asana.com/-/oauth_authorize",
+	TokenURL: "https://app.asana.com/-/oauth_token",
+}
+
+// NewPATDoer returns a Doer that authenticates every request with a
+// personal access token, then forwards it to doer.
+// If doer is nil then http.DefaultClient is used instead.
+//
+// https://developers.asana.com/docs/personal-access-token
+func NewPATDoer(token string, doer Doer) Doer {
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	return DoerFunc(func(req *http.Request) (*http.Response, error) {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return doer.Do(req)
+	})
+}
+
+// AuthCodeURL returns the URL the user should visit to authorize conf's
+// application, using PKCE as Asana's OAuth flow requires. Keep the
+// returned verifier around (e.g. in the user's session) and pass it to
+// Exchange alongside the code Asana redirects back with.
+func AuthCodeURL(conf *oauth2.Config, state string) (authURL, verifier string) {
+	verifier = oauth2.GenerateVerifier()
+	return conf.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier)), verifier
+}
+
+// Exchange trades an authorization code and its PKCE verifier (both from
+// AuthCodeURL) for a token.
+func Exchange(ctx context.Context, conf *oauth2.Config, code, verifier string) (*oauth2.Token, error) {
+	return conf.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+}
+
+// oauthDoer authenticates requests with an OAuth2 access token, letting
+// oauth2 refresh it when it is close to expiry. It also treats a 401
+// response as a sign the access token was revoked or expired early,
+// forcing a refresh and retrying the request once.
+type oauthDoer struct {
+	conf *oauth2.Config
+	doer Doer
+
+	mu  sync.Mutex
+	tok *oauth2.Token
+}
+
+// NewOAuthDoer returns a Doer that authenticates requests with tok,
+// refreshing it via conf as needed, then forwards them to doer.
+// If doer is nil then http.DefaultClient is used instead. On a 401
+// response it forces a token refresh and retries the request once
+// before giving up.
+func NewOAuthDoer(conf *oauth2.Config, tok *oauth2.Token, doer Doer) Doer {
+	if doer == nil {
+		doer = http.DefaultClient
+	}
+	return &oauthDoer{conf: conf, tok: tok, doer: doer}
+}
+
+func (d *oauthDoer) do(ctx context.Context, req *http.Request, refresh bool) (*http.Response, error) {
+	d.mu.Lock()
+	tok := d.tok
+	if refresh {
+		tok = &oauth2.Token{RefreshToken: tok.RefreshToken}
+	}
+	ts := d.conf.TokenSource(ctx, tok)
+	d.mu.Unlock()
+
+	tok, err := ts.Token()
+	if err != nil {
+		return nil, err
+	}
+	d.mu.Lock()
+	d.tok = tok
+	d.mu.Unlock()
+
+	tok.SetAuthHeader(req)
+	return d.doer.Do(req)
+}
+
+func (d *oauthDoer) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var bodyBytes []byte
+	if req.Body != nil {
+		b, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		bodyBytes = b
+		req.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
+	}
+
+	resp, err := d.do(ctx, req, false)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	if bodyBytes != nil {
+		req.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
+	}
+	return d.do(ctx, req, true)
+}
@@ -0,0 +1,98 @@
+package asana
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+func TestPATDoerSetsAuthHeader(t *testing.T) {
+	var gotAuth string
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/ping", func(w http.ResponseWriter, r *http.Request) {
+		gotAuth = r.Header.Get("Authorization")
+	})
+
+	req, _ := http.NewRequest("GET", server.URL+"/ping", nil)
+	if _, err := NewPATDoer("my-token", nil).Do(req); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if want := "Bearer my-token"; gotAuth != want {
+		t.Errorf("Authorization header = %q, want %q", gotAuth, want)
+	}
+}
+
+func TestPATDoerWrapsInnerDoer(t *testing.T) {
+	var called bool
+	inner := DoerFunc(func(req *http.Request) (*http.Response, error) {
+		called = true
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+
+	req, _ := http.NewRequest("GET", "http://example.com/ping", nil)
+	if _, err := NewPATDoer("my-token", inner).Do(req); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if !called {
+		t.Error("NewPATDoer did not forward the request to the wrapped Doer")
+	}
+}
+
+func TestOAuthDoerRefreshesOn401(t *testing.T) {
+	var tokenRequests int
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, tokenRequests)
+	}))
+	defer tokenServer.Close()
+
+	var apiRequests int
+	var gotBodies []string
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiRequests++
+		b, _ := ioutil.ReadAll(r.Body)
+		gotBodies = append(gotBodies, string(b))
+		if apiRequests == 1 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"data":{}}`)
+	}))
+	defer apiServer.Close()
+
+	conf := &oauth2.Config{Endpoint: oauth2.Endpoint{TokenURL: tokenServer.URL}}
+	tok := &oauth2.Token{AccessToken: "stale", RefreshToken: "refresh-me", Expiry: time.Now().Add(time.Hour)}
+	doer := NewOAuthDoer(conf, tok, nil)
+
+	req, _ := http.NewRequest("POST", apiServer.URL, bytes.NewBufferString(`{"data":{"name":"hi"}}`))
+	resp, err := doer.Do(req)
+	if err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("final status = %d, want %d", resp.StatusCode, http.StatusOK)
+	}
+	if apiRequests != 2 {
+		t.Errorf("api server received %d requests, want 2 (original + retry)", apiRequests)
+	}
+	if tokenRequests != 1 {
+		t.Errorf("token server received %d requests, want 1 (forced refresh after 401)", tokenRequests)
+	}
+	for i, b := range gotBodies {
+		if b != `{"data":{"name":"hi"}}` {
+			t.Errorf("request %d body = %s, want original body to be replayed", i, b)
+		}
+	}
+}
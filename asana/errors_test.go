@@ -0,0 +1,45 @@
+package asana
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestRequestErrorIsNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Asana-Request-Id", "req-1")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors":[{"message":"not found","help":"for help see docs"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	_, err := c.GetTask(context.Background(), "1", nil)
+	if !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetTask error = %v, want errors.Is match for ErrNotFound", err)
+	}
+
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("errors.As(%v, &ErrorResponse{}) = false, want true", err)
+	}
+	if errResp.StatusCode != http.StatusNotFound {
+		t.Errorf("ErrorResponse.StatusCode = %d, want %d", errResp.StatusCode, http.StatusNotFound)
+	}
+	if errResp.RequestID != "req-1" {
+		t.Errorf("ErrorResponse.RequestID = %q, want %q", errResp.RequestID, "req-1")
+	}
+	if len(errResp.Errors) != 1 || errResp.Errors[0].Help != "for help see docs" {
+		t.Errorf("ErrorResponse.Errors = %+v, want a single error with Help set", errResp.Errors)
+	}
+}
@@ -0,0 +1,145 @@
+package asana
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// pager drives cursor-based pagination for a single list endpoint. It is
+// embedded in the resource-specific pagers below so callers get a typed
+// Next method instead of a bare interface{}.
+type pager struct {
+	c    *Client
+	path string
+	opt  Filter
+	done bool
+}
+
+func newPager(c *Client, path string, opt *Filter) *pager {
+	if opt == nil {
+		opt = &Filter{}
+	}
+	return &pager{c: c, path: path, opt: *opt}
+}
+
+// next fetches the next page into v, which must be a pointer to a slice.
+// It returns io.EOF once the previous page was the last one.
+func (p *pager) next(ctx context.Context, v interface{}) error {
+	if p.done {
+		return io.EOF
+	}
+	next, err := p.c.request(ctx, "GET", p.path, nil, nil, &p.opt, v)
+	if err != nil {
+		return err
+	}
+	if next == nil || next.Offset == "" {
+		p.done = true
+	} else {
+		p.opt.Offset = next.Offset
+	}
+	return nil
+}
+
+// TaskPager pages through a task list, one page per Next call.
+type TaskPager struct{ *pager }
+
+// Tasks returns a TaskPager over "tasks", honoring opt's Limit and any
+// other filters. Call Next until it returns io.EOF.
+func (c *Client) Tasks(ctx context.Context, opt *Filter) *TaskPager {
+	return &TaskPager{newPager(c, "tasks", opt)}
+}
+
+// Next fetches the next page of tasks, returning io.EOF once exhausted.
+func (p *TaskPager) Next(ctx context.Context) ([]Task, error) {
+	tasks := new([]Task)
+	if err := p.next(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return *tasks, nil
+}
+
+// ProjectPager pages through a project list, one page per Next call.
+type ProjectPager struct{ *pager }
+
+// Projects returns a ProjectPager over "projects".
+func (c *Client) Projects(ctx context.Context, opt *Filter) *ProjectPager {
+	return &ProjectPager{newPager(c, "projects", opt)}
+}
+
+// Next fetches the next page of projects, returning io.EOF once exhausted.
+func (p *ProjectPager) Next(ctx context.Context) ([]Project, error) {
+	projects := new([]Project)
+	if err := p.next(ctx, projects); err != nil {
+		return nil, err
+	}
+	return *projects, nil
+}
+
+// UserPager pages through a user list, one page per Next call.
+type UserPager struct{ *pager }
+
+// Users returns a UserPager over "users".
+func (c *Client) Users(ctx context.Context, opt *Filter) *UserPager {
+	return &UserPager{newPager(c, "users", opt)}
+}
+
+// Next fetches the next page of users, returning io.EOF once exhausted.
+func (p *UserPager) Next(ctx context.Context) ([]User, error) {
+	users := new([]User)
+	if err := p.next(ctx, users); err != nil {
+		return nil, err
+	}
+	return *users, nil
+}
+
+// TagPager pages through a tag list, one page per Next call.
+type TagPager struct{ *pager }
+
+// Tags returns a TagPager over "tags".
+func (c *Client) Tags(ctx context.Context, opt *Filter) *TagPager {
+	return &TagPager{newPager(c, "tags", opt)}
+}
+
+// Next fetches the next page of tags, returning io.EOF once exhausted.
+func (p *TagPager) Next(ctx context.Context) ([]Tag, error) {
+	tags := new([]Tag)
+	if err := p.next(ctx, tags); err != nil {
+		return nil, err
+	}
+	return *tags, nil
+}
+
+// ProjectTaskPager pages through the tasks of a single project.
+type ProjectTaskPager struct{ *pager }
+
+// ProjectTasks returns a ProjectTaskPager over "projects/{projectID}/tasks".
+func (c *Client) ProjectTasks(ctx context.Context, projectID string, opt *Filter) *ProjectTaskPager {
+	return &ProjectTaskPager{newPager(c, fmt.Sprintf("projects/%s/tasks", projectID), opt)}
+}
+
+// Next fetches the next page of tasks, returning io.EOF once exhausted.
+func (p *ProjectTaskPager) Next(ctx context.Context) ([]Task, error) {
+	tasks := new([]Task)
+	if err := p.next(ctx, tasks); err != nil {
+		return nil, err
+	}
+	return *tasks, nil
+}
+
+// TaskStoryPager pages through the stories of a single task.
+type TaskStoryPager struct{ *pager }
+
+// TaskStories returns a TaskStoryPager over "tasks/{taskID}/stories".
+func (c *Client) TaskStories(ctx context.Context, taskID string, opt *Filter) *TaskStoryPager {
+	return &TaskStoryPager{newPager(c, fmt.Sprintf("tasks/%s/stories", taskID), opt)}
+}
+
+// Next fetches the next page of stories, returning io.EOF once exhausted.
+func (p *TaskStoryPager) Next(ctx context.Context) ([]Story, error) {
+	stories := new([]Story)
+	if err := p.next(ctx, stories); err != nil {
+		return nil, err
+	}
+	return *stories, nil
+}
@@ -0,0 +1,167 @@
+package asana
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestTaskPagerNext(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var requests int
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprint(w, `{"data":[{"gid":"1"}],"next_page":{"offset":"abc"}}`)
+		} else {
+			fmt.Fprint(w, `{"data":[{"gid":"2"}]}`)
+		}
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	ctx := context.Background()
+	pager := c.Tasks(ctx, nil)
+
+	page, err := pager.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "1" {
+		t.Errorf("Next page 1 = %+v, want task 1", page)
+	}
+
+	page, err = pager.Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "2" {
+		t.Errorf("Next page 2 = %+v, want task 2", page)
+	}
+
+	if _, err := pager.Next(ctx); err != io.EOF {
+		t.Errorf("Next after last page returned %v, want io.EOF", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("server received %d requests, want 2", requests)
+	}
+}
+
+func TestProjectPagerNext(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"gid":"1","name":"Project 1"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	ctx := context.Background()
+	page, err := c.Projects(ctx, nil).Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "1" {
+		t.Errorf("Next = %+v, want project 1", page)
+	}
+}
+
+func TestUserPagerNext(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"gid":"1","email":"test1@asana.com"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	ctx := context.Background()
+	page, err := c.Users(ctx, nil).Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "1" {
+		t.Errorf("Next = %+v, want user 1", page)
+	}
+}
+
+func TestTagPagerNext(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tags", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"gid":"1","name":"Tag 1"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	ctx := context.Background()
+	page, err := c.Tags(ctx, nil).Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "1" {
+		t.Errorf("Next = %+v, want tag 1", page)
+	}
+}
+
+func TestProjectTaskPagerNext(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/projects/1/tasks", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"gid":"1","name":"Task 1"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	ctx := context.Background()
+	page, err := c.ProjectTasks(ctx, "1", nil).Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "1" {
+		t.Errorf("Next = %+v, want task 1", page)
+	}
+}
+
+func TestTaskStoryPagerNext(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1/stories", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[{"gid":"1","text":"Story 1"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	ctx := context.Background()
+	page, err := c.TaskStories(ctx, "1", nil).Next(ctx)
+	if err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].ID != "1" {
+		t.Errorf("Next = %+v, want story 1", page)
+	}
+}
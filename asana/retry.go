@@ -0,0 +1,101 @@
+package asana
+
+import (
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type (
+	// RetryPolicy controls how c.request retries 429 and 5xx responses.
+	RetryPolicy struct {
+		// MaxRetries is the maximum number of retry attempts. Zero disables
+		// retries entirely.
+		MaxRetries int
+		// BaseDelay is the backoff before the first retry; it doubles on
+		// each subsequent attempt.
+		BaseDelay time.Duration
+		// MaxDelay caps the computed backoff, including any Retry-After
+		// value the server sent.
+		MaxDelay time.Duration
+		// Jitter is a fraction (0-1) of the computed delay added at
+		// random, to avoid retries from many clients lining up.
+		Jitter float64
+		// ShouldRetry overrides the default classification, which retries
+		// 429 and any 5xx status code.
+		ShouldRetry func(statusCode int) bool
+	}
+
+	// RateLimit reflects the rate-limit headers Asana returned on the most
+	// recent response that carried them.
+	RateLimit struct {
+		Limit     int
+		Remaining int
+		Reset     time.Time
+	}
+)
+
+// DefaultRetryPolicy is used by NewClient. It retries up to 3 times with
+// exponential backoff starting at 1 second, capped at 30 seconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  time.Second,
+	MaxDelay:   30 * time.Second,
+	Jitter:     0.2,
+}
+
+func (p RetryPolicy) shouldRetry(statusCode int) bool {
+	if p.ShouldRetry != nil {
+		return p.ShouldRetry(statusCode)
+	}
+	return statusCode == http.StatusTooManyRequests || (statusCode >= 500 && statusCode < 600)
+}
+
+// delay computes the backoff before the given (zero-based) retry attempt,
+// honoring a Retry-After header value if it is larger than the computed
+// backoff.
+func (p RetryPolicy) delay(attempt int, retryAfter string) time.Duration {
+	d := p.BaseDelay
+	for i := 0; i < attempt; i++ {
+		d *= 2
+	}
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds > 0 {
+		if ra := time.Duration(seconds) * time.Second; ra > d {
+			d = ra
+		}
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if p.Jitter > 0 {
+		d += time.Duration(rand.Float64() * p.Jitter * float64(d))
+	}
+	return d
+}
+
+// recordRateLimit stores the rate-limit headers from resp for later
+// introspection via Client.RateLimit.
+func (c *Client) recordRateLimit(header http.Header) {
+	limit, errL := strconv.Atoi(header.Get("X-RateLimit-Limit"))
+	remaining, errR := strconv.Atoi(header.Get("X-RateLimit-Remaining"))
+	if errL != nil && errR != nil {
+		return
+	}
+	var reset time.Time
+	if epoch, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		reset = time.Unix(epoch, 0)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.rateLimit = RateLimit{Limit: limit, Remaining: remaining, Reset: reset}
+}
+
+// RateLimit returns the rate-limit headers observed on the most recent
+// response to c that carried them; a response without them leaves the
+// previous value in place.
+func (c *Client) RateLimit() RateLimit {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rateLimit
+}
@@ -0,0 +1,89 @@
+package asana
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestRequestRetriesOn429(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var requests int
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"gid":"1"}]}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+	c.RetryPolicy = RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	tasks, err := c.ListTasks(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("ListTasks returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, want 2", requests)
+	}
+	if len(tasks) != 1 || tasks[0].ID != "1" {
+		t.Errorf("ListTasks returned %+v, want task 1", tasks)
+	}
+}
+
+func TestRequestGivesUpAfterMaxRetries(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var requests int
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+	c.RetryPolicy = RetryPolicy{MaxRetries: 2, BaseDelay: time.Millisecond}
+
+	if _, err := c.ListTasks(context.Background(), nil); err == nil {
+		t.Error("ListTasks returned no error, want a decode error for the empty 429 body")
+	}
+	if requests != 3 {
+		t.Errorf("server received %d requests, want 3", requests)
+	}
+}
+
+func TestRequestSurfacesDecodeErrorOnFailureStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, "<html>gateway error</html>")
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+	c.RetryPolicy = RetryPolicy{MaxRetries: 0}
+
+	_, err := c.ListTasks(context.Background(), nil)
+	if err == nil {
+		t.Fatal("ListTasks returned no error for a non-JSON 500 body, want a decode error")
+	}
+	if _, ok := err.(*ErrorResponse); ok {
+		t.Errorf("ListTasks returned %v (*ErrorResponse with empty Errors), want the underlying decode error surfaced instead", err)
+	}
+}
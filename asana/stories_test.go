@@ -0,0 +1,41 @@
+package asana
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestCreateStory(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1/stories", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, "POST")
+		b, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatalf("error reading request body: %v", err)
+		}
+		want := `{"data":{"html_text":"Hi there","is_pinned":true}}`
+		if string(b) != want {
+			t.Errorf("request body = %s, want %s", b, want)
+		}
+		fmt.Fprint(w, `{"data":{"gid":"1","html_text":"Hi there"}}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	story, err := c.CreateStory(context.Background(), "1", StoryCreate{HTMLText: "Hi there", IsPinned: true}, nil)
+	if err != nil {
+		t.Fatalf("CreateStory returned error: %v", err)
+	}
+	if story.ID != "1" || story.HTMLText != "Hi there" {
+		t.Errorf("CreateStory returned %+v, want gid 1 with html_text set", story)
+	}
+}
@@ -0,0 +1,203 @@
+package asana
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/url"
+	"time"
+)
+
+type (
+	// Section is a named subdivision of tasks within a project.
+	Section struct {
+		ID   string `json:"gid,omitempty"`
+		Name string `json:"name,omitempty"`
+	}
+
+	// Attachment is a file attached to a task.
+	Attachment struct {
+		ID          string    `json:"gid,omitempty"`
+		Name        string    `json:"name,omitempty"`
+		CreatedAt   time.Time `json:"created_at,omitempty"`
+		DownloadURL string    `json:"download_url,omitempty"`
+		Host        string    `json:"host,omitempty"`
+		Parent      *Task     `json:"parent,omitempty"`
+	}
+)
+
+// AddFollowers adds userIDs as followers of task id.
+//
+// https://asana.com/developers/api-reference/tasks#addfollowers
+func (c *Client) AddFollowers(ctx context.Context, id string, userIDs []string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"followers": userIDs}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/addFollowers", id), data, nil, opt, task)
+	return *task, err
+}
+
+// RemoveFollowers removes userIDs from task id's followers.
+//
+// https://asana.com/developers/api-reference/tasks#removefollowers
+func (c *Client) RemoveFollowers(ctx context.Context, id string, userIDs []string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"followers": userIDs}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/removeFollowers", id), data, nil, opt, task)
+	return *task, err
+}
+
+// ListSubtasks lists the subtasks of task id.
+//
+// https://asana.com/developers/api-reference/tasks#subtasks
+func (c *Client) ListSubtasks(ctx context.Context, id string, opt *Filter) ([]Task, error) {
+	tasks := new([]Task)
+	err := c.Request(ctx, fmt.Sprintf("tasks/%s/subtasks", id), opt, tasks)
+	return *tasks, err
+}
+
+// AddSubtask creates a new subtask under task id.
+//
+// https://asana.com/developers/api-reference/tasks#subtasks
+func (c *Client) AddSubtask(ctx context.Context, id string, fields map[string]string, opt *Filter) (Task, error) {
+	task := new(Task)
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/subtasks", id), nil, toURLValues(fields), opt, task)
+	return *task, err
+}
+
+// SetParent changes task id's parent task to parentID. Pass an empty
+// parentID to make the task a top-level task again.
+//
+// https://asana.com/developers/api-reference/tasks#setparent
+func (c *Client) SetParent(ctx context.Context, id string, parentID string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"parent": parentID}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/setParent", id), data, nil, opt, task)
+	return *task, err
+}
+
+// AddDependencies marks dependencyIDs as dependencies of task id.
+//
+// https://asana.com/developers/api-reference/tasks#adddependencies
+func (c *Client) AddDependencies(ctx context.Context, id string, dependencyIDs []string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"dependencies": dependencyIDs}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/addDependencies", id), data, nil, opt, task)
+	return *task, err
+}
+
+// RemoveDependencies removes dependencyIDs as dependencies of task id.
+//
+// https://asana.com/developers/api-reference/tasks#removedependencies
+func (c *Client) RemoveDependencies(ctx context.Context, id string, dependencyIDs []string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"dependencies": dependencyIDs}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/removeDependencies", id), data, nil, opt, task)
+	return *task, err
+}
+
+// AddProject adds task id to projectID. insertBefore, insertAfter, and
+// section are optional task/section gids controlling placement within the
+// project; pass an empty string to omit one.
+//
+// https://asana.com/developers/api-reference/tasks#addproject
+func (c *Client) AddProject(ctx context.Context, id string, projectID string, insertBefore, insertAfter, section string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"project": projectID}
+	if insertBefore != "" {
+		data["insert_before"] = insertBefore
+	}
+	if insertAfter != "" {
+		data["insert_after"] = insertAfter
+	}
+	if section != "" {
+		data["section"] = section
+	}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/addProject", id), data, nil, opt, task)
+	return *task, err
+}
+
+// RemoveProject removes task id from projectID.
+//
+// https://asana.com/developers/api-reference/tasks#removeproject
+func (c *Client) RemoveProject(ctx context.Context, id string, projectID string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"project": projectID}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/removeProject", id), data, nil, opt, task)
+	return *task, err
+}
+
+// AddTag adds tagID to task id.
+//
+// https://asana.com/developers/api-reference/tasks#addtag
+func (c *Client) AddTag(ctx context.Context, id string, tagID string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"tag": tagID}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/addTag", id), data, nil, opt, task)
+	return *task, err
+}
+
+// RemoveTag removes tagID from task id.
+//
+// https://asana.com/developers/api-reference/tasks#removetag
+func (c *Client) RemoveTag(ctx context.Context, id string, tagID string, opt *Filter) (Task, error) {
+	task := new(Task)
+	data := map[string]interface{}{"tag": tagID}
+	_, err := c.request(ctx, "POST", fmt.Sprintf("tasks/%s/removeTag", id), data, nil, opt, task)
+	return *task, err
+}
+
+// ListSections lists the sections of projectID.
+//
+// https://asana.com/developers/api-reference/sections#sections
+func (c *Client) ListSections(ctx context.Context, projectID string, opt *Filter) ([]Section, error) {
+	sections := new([]Section)
+	err := c.Request(ctx, fmt.Sprintf("projects/%s/sections", projectID), opt, sections)
+	return *sections, err
+}
+
+// CreateSection creates a new section in projectID.
+//
+// https://asana.com/developers/api-reference/sections#create
+func (c *Client) CreateSection(ctx context.Context, projectID string, fields map[string]string, opt *Filter) (Section, error) {
+	section := new(Section)
+	_, err := c.request(ctx, "POST", fmt.Sprintf("projects/%s/sections", projectID), nil, toURLValues(fields), opt, section)
+	return *section, err
+}
+
+// CreateAttachment uploads r as an attachment named filename on task id.
+// r is read to completion but not closed.
+//
+// https://asana.com/developers/api-reference/attachments#create
+func (c *Client) CreateAttachment(ctx context.Context, id string, filename string, r io.Reader, opt *Filter) (Attachment, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile("file", filename)
+	if err != nil {
+		return Attachment{}, err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return Attachment{}, err
+	}
+	if err := mw.Close(); err != nil {
+		return Attachment{}, err
+	}
+
+	if opt == nil {
+		opt = &Filter{}
+	}
+	urlStr, err := addOptions(fmt.Sprintf("tasks/%s/attachments", id), opt)
+	if err != nil {
+		return Attachment{}, err
+	}
+	rel, err := url.Parse(urlStr)
+	if err != nil {
+		return Attachment{}, err
+	}
+	u := c.BaseURL.ResolveReference(rel)
+
+	attachment := new(Attachment)
+	_, err = c.doWithRetry(ctx, "POST", u.String(), buf.Bytes(), mw.FormDataContentType(), attachment)
+	return *attachment, err
+}
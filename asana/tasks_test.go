@@ -0,0 +1,225 @@
+package asana
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestAddFollowers(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1/addFollowers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Request method: %v, want POST", r.Method)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if want := `{"data":{"followers":["2","3"]}}`; string(b) != want {
+			t.Errorf("request body = %s, want %s", b, want)
+		}
+		fmt.Fprint(w, `{"data":{"gid":"1"}}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	task, err := c.AddFollowers(context.Background(), "1", []string{"2", "3"}, nil)
+	if err != nil {
+		t.Fatalf("AddFollowers returned error: %v", err)
+	}
+	if task.ID != "1" {
+		t.Errorf("AddFollowers returned %+v, want task 1", task)
+	}
+}
+
+func TestRemoveFollowers(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1/removeFollowers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Request method: %v, want POST", r.Method)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if want := `{"data":{"followers":["2"]}}`; string(b) != want {
+			t.Errorf("request body = %s, want %s", b, want)
+		}
+		fmt.Fprint(w, `{"data":{"gid":"1"}}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	task, err := c.RemoveFollowers(context.Background(), "1", []string{"2"}, nil)
+	if err != nil {
+		t.Fatalf("RemoveFollowers returned error: %v", err)
+	}
+	if task.ID != "1" {
+		t.Errorf("RemoveFollowers returned %+v, want task 1", task)
+	}
+}
+
+func TestAddTag(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1/addTag", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Request method: %v, want POST", r.Method)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if want := `{"data":{"tag":"9"}}`; string(b) != want {
+			t.Errorf("request body = %s, want %s", b, want)
+		}
+		fmt.Fprint(w, `{"data":{"gid":"1"}}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	task, err := c.AddTag(context.Background(), "1", "9", nil)
+	if err != nil {
+		t.Fatalf("AddTag returned error: %v", err)
+	}
+	if task.ID != "1" {
+		t.Errorf("AddTag returned %+v, want task 1", task)
+	}
+}
+
+func TestAddProject(t *testing.T) {
+	tests := []struct {
+		name                               string
+		insertBefore, insertAfter, section string
+		want                               string
+	}{
+		{
+			name: "project only",
+			want: `{"data":{"project":"1"}}`,
+		},
+		{
+			name:         "insert before",
+			insertBefore: "2",
+			want:         `{"data":{"insert_before":"2","project":"1"}}`,
+		},
+		{
+			name:        "insert after",
+			insertAfter: "3",
+			want:        `{"data":{"insert_after":"3","project":"1"}}`,
+		},
+		{
+			name:    "section",
+			section: "4",
+			want:    `{"data":{"project":"1","section":"4"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			mux.HandleFunc("/tasks/1/addProject", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "POST" {
+					t.Errorf("Request method: %v, want POST", r.Method)
+				}
+				b, _ := ioutil.ReadAll(r.Body)
+				if string(b) != tt.want {
+					t.Errorf("request body = %s, want %s", b, tt.want)
+				}
+				fmt.Fprint(w, `{"data":{"gid":"1"}}`)
+			})
+
+			c := NewClient(nil)
+			c.BaseURL, _ = url.Parse(server.URL)
+
+			task, err := c.AddProject(context.Background(), "1", "1", tt.insertBefore, tt.insertAfter, tt.section, nil)
+			if err != nil {
+				t.Fatalf("AddProject returned error: %v", err)
+			}
+			if task.ID != "1" {
+				t.Errorf("AddProject returned %+v, want task 1", task)
+			}
+		})
+	}
+}
+
+func TestCreateAttachment(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/tasks/1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("Request method: %v, want POST", r.Method)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("ParseMultipartForm: %v", err)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("FormFile: %v", err)
+		}
+		defer file.Close()
+		if header.Filename != "report.txt" {
+			t.Errorf("filename = %q, want %q", header.Filename, "report.txt")
+		}
+		fmt.Fprint(w, `{"data":{"gid":"1","name":"report.txt"}}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+
+	attachment, err := c.CreateAttachment(context.Background(), "1", "report.txt", bytes.NewBufferString("contents"), nil)
+	if err != nil {
+		t.Fatalf("CreateAttachment returned error: %v", err)
+	}
+	if attachment.ID != "1" || attachment.Name != "report.txt" {
+		t.Errorf("CreateAttachment returned %+v, want gid 1 named report.txt", attachment)
+	}
+}
+
+func TestCreateAttachmentRetriesOn429(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var requests int
+	mux.HandleFunc("/tasks/1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		if _, _, err := r.FormFile("file"); err != nil {
+			t.Fatalf("FormFile on retried request: %v", err)
+		}
+		fmt.Fprint(w, `{"data":{"gid":"1","name":"report.txt"}}`)
+	})
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(server.URL)
+	c.RetryPolicy = RetryPolicy{MaxRetries: 1, BaseDelay: time.Millisecond}
+
+	attachment, err := c.CreateAttachment(context.Background(), "1", "report.txt", bytes.NewBufferString("contents"), nil)
+	if err != nil {
+		t.Fatalf("CreateAttachment returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("server received %d requests, want 2", requests)
+	}
+	if attachment.ID != "1" {
+		t.Errorf("CreateAttachment returned %+v, want attachment 1", attachment)
+	}
+}
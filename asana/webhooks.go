@@ -0,0 +1,214 @@
+package asana
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type (
+	// ResourceRef is the minimal {gid, resource_type} shape Asana uses to
+	// reference a resource without embedding its full representation.
+	ResourceRef struct {
+		ID           string `json:"gid,omitempty"`
+		ResourceType string `json:"resource_type,omitempty"`
+	}
+
+	// Webhook subscribes target to changes on resource.
+	Webhook struct {
+		ID       string      `json:"gid,omitempty"`
+		Resource ResourceRef `json:"resource,omitempty"`
+		Target   string      `json:"target,omitempty"`
+		Active   bool        `json:"active,omitempty"`
+	}
+
+	// EventChange describes what changed on an Event's resource.
+	EventChange struct {
+		Field        string      `json:"field,omitempty"`
+		Action       string      `json:"action,omitempty"`
+		NewValue     interface{} `json:"new_value,omitempty"`
+		AddedValue   interface{} `json:"added_value,omitempty"`
+		RemovedValue interface{} `json:"removed_value,omitempty"`
+	}
+
+	// Event is a single change delivered in a webhook payload.
+	Event struct {
+		User      ResourceRef  `json:"user,omitempty"`
+		Resource  ResourceRef  `json:"resource,omitempty"`
+		Action    string       `json:"action,omitempty"`
+		Parent    *ResourceRef `json:"parent,omitempty"`
+		CreatedAt time.Time    `json:"created_at,omitempty"`
+		Change    *EventChange `json:"change,omitempty"`
+	}
+
+	// SecretStore persists the per-target HMAC secret Asana sends during
+	// the webhook handshake, keyed by WebhookHandler on the delivery
+	// request's URL path. The default, used unless WebhookHandler.Store is
+	// replaced, is an in-memory map: it holds secrets for any number of
+	// targets, but they are lost when the process exits and are not
+	// shared between processes.
+	SecretStore interface {
+		SetSecret(key, secret string) error
+		GetSecret(key string) (string, error)
+	}
+
+	// WebhookHandler implements Asana's two-phase webhook protocol: it
+	// answers the handshake POST by echoing back X-Hook-Secret, then
+	// verifies X-Hook-Signature on every later delivery before invoking
+	// OnEvents. A handshake POST for a path that already has a secret
+	// (recorded in Store, or the fallback secret given to
+	// NewWebhookHandler) gets a 200 without the echo and leaves the
+	// secret unchanged.
+	WebhookHandler struct {
+		Store    SecretStore
+		OnEvents func(context.Context, []Event) error
+
+		fallbackSecret string
+	}
+
+	webhookPayload struct {
+		Events []Event `json:"events"`
+	}
+)
+
+// CreateWebhook subscribes target to changes on resource.
+//
+// https://asana.com/developers/api-reference/webhooks#create
+func (c *Client) CreateWebhook(ctx context.Context, resource, target string) (Webhook, error) {
+	wh := new(Webhook)
+	data := map[string]interface{}{"resource": resource, "target": target}
+	_, err := c.request(ctx, "POST", "webhooks", data, nil, nil, wh)
+	return *wh, err
+}
+
+// ListWebhooks lists the webhooks visible to the current user, filtered by
+// opt (Asana requires a workspace filter).
+//
+// https://asana.com/developers/api-reference/webhooks#query
+func (c *Client) ListWebhooks(ctx context.Context, opt *Filter) ([]Webhook, error) {
+	webhooks := new([]Webhook)
+	err := c.Request(ctx, "webhooks", opt, webhooks)
+	return *webhooks, err
+}
+
+// GetWebhook fetches a single webhook by id.
+//
+// https://asana.com/developers/api-reference/webhooks#get-single
+func (c *Client) GetWebhook(ctx context.Context, id string, opt *Filter) (Webhook, error) {
+	wh := new(Webhook)
+	err := c.Request(ctx, fmt.Sprintf("webhooks/%s", id), opt, wh)
+	return *wh, err
+}
+
+// DeleteWebhook deletes webhook id.
+//
+// https://asana.com/developers/api-reference/webhooks#delete
+func (c *Client) DeleteWebhook(ctx context.Context, id string) error {
+	_, err := c.request(ctx, "DELETE", fmt.Sprintf("webhooks/%s", id), nil, nil, nil, nil)
+	return err
+}
+
+// NewWebhookHandler returns a WebhookHandler that dispatches verified
+// deliveries to onEvents. secret, if non-empty, is used to verify
+// deliveries to any target whose handshake secret hasn't been recorded
+// yet in Store -- useful when a secret from a prior handshake was
+// persisted outside the process and is being restored. Assign Store
+// before serving requests to plug in durable, multi-webhook storage.
+func NewWebhookHandler(secret string, onEvents func(context.Context, []Event) error) *WebhookHandler {
+	return &WebhookHandler{
+		Store:          newMemorySecretStore(),
+		OnEvents:       onEvents,
+		fallbackSecret: secret,
+	}
+}
+
+// ServeHTTP implements Asana's webhook handshake and delivery protocol.
+func (h *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	secret, err := h.Store.GetSecret(r.URL.Path)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if secret == "" {
+		secret = h.fallbackSecret
+	}
+
+	if hookSecret := r.Header.Get("X-Hook-Secret"); hookSecret != "" {
+		// Only the one-time handshake may establish a secret. Once one is
+		// recorded for this path (from Store or fallbackSecret), a later
+		// X-Hook-Secret POST is either a redelivered handshake or an
+		// attacker trying to plant a known secret -- ignore it rather than
+		// letting it silently replace the trusted value.
+		if secret != "" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if err := h.Store.SetSecret(r.URL.Path, hookSecret); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("X-Hook-Secret", hookSecret)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if secret == "" || !validSignature(secret, body, r.Header.Get("X-Hook-Signature")) {
+		http.Error(w, "asana: invalid webhook signature", http.StatusUnauthorized)
+		return
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.OnEvents(r.Context(), payload.Events); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	want := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(signature), []byte(want))
+}
+
+// memorySecretStore is the default SecretStore: an in-memory map, good
+// enough for a single process handling a handful of webhooks.
+type memorySecretStore struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+func newMemorySecretStore() *memorySecretStore {
+	return &memorySecretStore{secrets: make(map[string]string)}
+}
+
+func (s *memorySecretStore) SetSecret(key, secret string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.secrets[key] = secret
+	return nil
+}
+
+func (s *memorySecretStore) GetSecret(key string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.secrets[key], nil
+}
@@ -0,0 +1,112 @@
+package asana
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWebhookHandlerHandshakeAndDelivery(t *testing.T) {
+	var got []Event
+	handler := NewWebhookHandler("", func(ctx context.Context, events []Event) error {
+		got = events
+		return nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// Phase 1: handshake.
+	req, _ := http.NewRequest("POST", server.URL+"/hooks/1", nil)
+	req.Header.Set("X-Hook-Secret", "shh")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("handshake request failed: %v", err)
+	}
+	if resp.Header.Get("X-Hook-Secret") != "shh" {
+		t.Errorf("handshake response X-Hook-Secret = %q, want %q", resp.Header.Get("X-Hook-Secret"), "shh")
+	}
+	resp.Body.Close()
+
+	// Phase 2: signed delivery.
+	body := `{"events":[{"action":"changed","resource":{"gid":"1","resource_type":"task"}}]}`
+	mac := hmac.New(sha256.New, []byte("shh"))
+	mac.Write([]byte(body))
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	req, _ = http.NewRequest("POST", server.URL+"/hooks/1", strings.NewReader(body))
+	req.Header.Set("X-Hook-Signature", sig)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("delivery request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("delivery status = %d, want 200", resp.StatusCode)
+	}
+	if len(got) != 1 || got[0].Resource.ID != "1" {
+		t.Errorf("onEvents received %+v, want a single event for resource 1", got)
+	}
+
+	// Phase 3: a bad signature must be rejected.
+	req, _ = http.NewRequest("POST", server.URL+"/hooks/1", strings.NewReader(body))
+	req.Header.Set("X-Hook-Signature", "deadbeef")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("delivery request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("bad signature status = %d, want 401", resp.StatusCode)
+	}
+}
+
+func TestWebhookHandlerRejectsReHandshake(t *testing.T) {
+	handler := NewWebhookHandler("", func(ctx context.Context, events []Event) error {
+		return nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// Legitimate handshake establishes the secret.
+	req, _ := http.NewRequest("POST", server.URL+"/hooks/1", nil)
+	req.Header.Set("X-Hook-Secret", "shh")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("handshake request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	// An attacker attempting to overwrite the already-provisioned secret
+	// must be ignored, not accepted.
+	req, _ = http.NewRequest("POST", server.URL+"/hooks/1", nil)
+	req.Header.Set("X-Hook-Secret", "attacker-value")
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("re-handshake request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	// A delivery forged with the attacker's secret must still be rejected.
+	body := `{"events":[{"action":"changed","resource":{"gid":"1","resource_type":"task"}}]}`
+	mac := hmac.New(sha256.New, []byte("attacker-value"))
+	mac.Write([]byte(body))
+	sig := hex.EncodeToString(mac.Sum(nil))
+
+	req, _ = http.NewRequest("POST", server.URL+"/hooks/1", strings.NewReader(body))
+	req.Header.Set("X-Hook-Signature", sig)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("forged delivery request failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("forged delivery status = %d, want 401 (re-handshake must not hijack the stored secret)", resp.StatusCode)
+	}
+}